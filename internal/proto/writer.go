@@ -1,6 +1,7 @@
 package proto
 
 import (
+	"bytes"
 	"encoding"
 	"fmt"
 	"io"
@@ -146,6 +147,67 @@ func (w *Writer) WriteArg(v interface{}) error {
 	}
 }
 
+// Encode serializes a reply decoded by Reader.ReadReply back into RESP2.
+// Status replies decode to plain strings, so they are written as bulk strings.
+func Encode(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := NewWriter(&buf).WriteReply(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// WriteReply writes a decoded reply: nil, string, RedisError, int64 and
+// arbitrarily nested []interface{} values.
+func (w *Writer) WriteReply(v interface{}) error {
+	switch v := v.(type) {
+	case nil:
+		return w.nilReply()
+	case string:
+		return w.string(v)
+	case RedisError:
+		if v == Nil {
+			return w.nilReply()
+		}
+		if err := w.WriteByte(RespError); err != nil {
+			return err
+		}
+		if _, err := w.WriteString(string(v)); err != nil {
+			return err
+		}
+		return w.crlf()
+	case int64:
+		if err := w.WriteByte(RespInt); err != nil {
+			return err
+		}
+		w.numBuf = strconv.AppendInt(w.numBuf[:0], v, 10)
+		if _, err := w.Write(w.numBuf); err != nil {
+			return err
+		}
+		return w.crlf()
+	case []interface{}:
+		if err := w.WriteByte(RespArray); err != nil {
+			return err
+		}
+		if err := w.writeLen(len(v)); err != nil {
+			return err
+		}
+		for _, elem := range v {
+			if err := w.WriteReply(elem); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("redis: can't encode %T reply", v)
+	}
+}
+
+func (w *Writer) nilReply() error {
+	_, err := w.WriteString("$-1\r\n")
+	return err
+}
+
 func (w *Writer) bytes(b []byte) error {
 	if err := w.WriteByte(RespString); err != nil {
 		return err
@@ -152,3 +152,58 @@ var _ = Describe("WriteArg", func() {
 		})
 	}
 })
+
+var _ = Describe("Encode", func() {
+	decode := func(b []byte) (interface{}, error) {
+		return proto.NewReader(bytes.NewReader(b)).ReadReply()
+	}
+
+	replies := []string{
+		"$5\r\nhello\r\n",
+		"$0\r\n\r\n",
+		":42\r\n",
+		":-1\r\n",
+		"*0\r\n",
+		"*3\r\n$1\r\na\r\n:1\r\n*2\r\n$-1\r\n-ERR nested\r\n",
+	}
+
+	for _, reply := range replies {
+		reply := reply
+		It(fmt.Sprintf("should round-trip %q", reply), func() {
+			v, err := decode([]byte(reply))
+			Expect(err).NotTo(HaveOccurred())
+
+			b, err := proto.Encode(v)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(Equal(reply))
+		})
+	}
+
+	It("should encode status replies as bulk strings", func() {
+		v, err := decode([]byte("+OK\r\n"))
+		Expect(err).NotTo(HaveOccurred())
+
+		b, err := proto.Encode(v)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(b)).To(Equal("$2\r\nOK\r\n"))
+	})
+
+	It("should encode nil and error replies", func() {
+		_, err := decode([]byte("$-1\r\n"))
+		Expect(err).To(Equal(proto.Nil))
+		b, err := proto.Encode(err)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(b)).To(Equal("$-1\r\n"))
+
+		_, err = decode([]byte("-WRONGTYPE bad\r\n"))
+		Expect(err).To(HaveOccurred())
+		b, err = proto.Encode(err)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(b)).To(Equal("-WRONGTYPE bad\r\n"))
+	})
+
+	It("should reject non-RESP2 values", func() {
+		_, err := proto.Encode(1.5)
+		Expect(err).To(MatchError("redis: can't encode float64 reply"))
+	})
+})